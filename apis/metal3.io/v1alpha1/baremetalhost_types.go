@@ -153,6 +153,10 @@ const (
 	// RegistrationFailedReason is the reason used when the BareMetalHost is not
 	// registered.
 	RegistrationFailedReason = "RegistrationFailed"
+	// MACAddressConflictReason is the reason used when the BareMetalHost
+	// cannot be registered because its BootMACAddress is already used by
+	// another node in the provisioner.
+	MACAddressConflictReason = "MACAddressConflict"
 	// PowerFailureReason is the reason used when the BareMetalHost is experiencing a
 	// power failure.
 	PowerFailureReason = "PowerFailure"
@@ -894,6 +894,11 @@ func (r *BareMetalHostReconciler) registerHost(ctx context.Context, prov provisi
 		return recordActionFailure(info, metal3api.RegistrationError,
 			"Preprovisioning Image is not acceptable to provisioner")
 	}
+	var macConflict provisioner.MACAddressConflictError
+	if errors.As(err, &macConflict) {
+		setMACAddressConflictCondition(info.host, macConflict.Error())
+		return recordActionFailure(info, metal3api.RegistrationError, macConflict.Error())
+	}
 	if err != nil {
 		noManagementAccess.Inc()
 		return actionError{fmt.Errorf("failed to validate BMC access: %w", err)}
@@ -2215,6 +2220,26 @@ func setConditionFalse(host *metal3api.BareMetalHost, typ, reason string) {
 	conditions.Set(host, metav1.Condition{Type: typ, Status: metav1.ConditionFalse, Reason: reason})
 }
 
+// setMACAddressConflictCondition records a registration failure caused by
+// the boot MAC address being used by another node. The message is also the
+// host's error message, which is how hasMACAddressConflict recognizes it.
+func setMACAddressConflictCondition(host *metal3api.BareMetalHost, message string) {
+	conditions.Set(host, metav1.Condition{
+		Type:    metal3api.ManageableCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  metal3api.MACAddressConflictReason,
+		Message: message,
+	})
+}
+
+// hasMACAddressConflict returns true if the current registration error of
+// the host is the MAC address conflict recorded by registerHost.
+func hasMACAddressConflict(host *metal3api.BareMetalHost) bool {
+	return host.Status.ErrorType == metal3api.RegistrationError &&
+		conditions.GetReason(host, metal3api.ManageableCondition) == metal3api.MACAddressConflictReason &&
+		conditions.GetMessage(host, metal3api.ManageableCondition) == host.Status.ErrorMessage
+}
+
 func setConditionsProgressing(host *metal3api.BareMetalHost, progressingReason string) {
 	setConditionTrue(host, metal3api.ManageableCondition, metal3api.ManageableReason)
 	setConditionFalse(host, metal3api.AvailableForProvisioningCondition, metal3api.NotAvailableReason)
@@ -2232,10 +2257,13 @@ func computeConditions(ctx context.Context, host *metal3api.BareMetalHost, prov
 		setConditionFalse(host, metal3api.ReadyCondition, metal3api.NotProvisionedReason)
 		setConditionFalse(host, metal3api.ProgressingCondition, metal3api.NotProgressingReason)
 	case metal3api.StateRegistering:
-		if host.Status.OperationalStatus == metal3api.OperationalStatusError {
-			setConditionFalse(host, metal3api.ManageableCondition, metal3api.RegistrationFailedReason)
-		} else {
+		switch {
+		case host.Status.OperationalStatus != metal3api.OperationalStatusError:
 			setConditionFalse(host, metal3api.ManageableCondition, metal3api.RegisteringReason)
+		case hasMACAddressConflict(host):
+			setMACAddressConflictCondition(host, host.Status.ErrorMessage)
+		default:
+			setConditionFalse(host, metal3api.ManageableCondition, metal3api.RegistrationFailedReason)
 		}
 		setConditionFalse(host, metal3api.AvailableForProvisioningCondition, metal3api.NotAvailableReason)
 		setConditionFalse(host, metal3api.ProvisionedCondition, metal3api.NotProvisionedReason)
@@ -11,6 +11,7 @@ import (
 
 	metal3api "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/metal3-io/baremetal-operator/pkg/hardwareutils/bmc"
+	"github.com/metal3-io/baremetal-operator/pkg/provisioner"
 	"github.com/metal3-io/baremetal-operator/pkg/provisioner/fixture"
 	"github.com/metal3-io/baremetal-operator/pkg/secretutils"
 	promutil "github.com/prometheus/client_golang/prometheus/testutil"
@@ -2381,6 +2382,39 @@ func TestInvalidBMHCanBeDeleted(t *testing.T) {
 	})
 }
 
+func TestRegistrationMACAddressConflict(t *testing.T) {
+	host := newDefaultHost(t)
+
+	var fix fixture.Fixture
+	r := newTestReconcilerWithFixture(t, &fix, host)
+
+	fix.SetRegisterError(provisioner.MACAddressConflictError{
+		Address:      host.Spec.BootMACAddress,
+		ExistingNode: "other-node",
+	})
+	waitForError(t, r, host)
+
+	expectedMessage := fmt.Sprintf("MAC address %s conflicts with existing node other-node", host.Spec.BootMACAddress)
+	assert.Equal(t, metal3api.StateRegistering, host.Status.Provisioning.State)
+	assert.Equal(t, metal3api.RegistrationError, host.Status.ErrorType)
+	assert.Equal(t, expectedMessage, host.Status.ErrorMessage)
+	assert.True(t, conditions.IsFalse(host, metal3api.ManageableCondition))
+	assert.Equal(t, metal3api.MACAddressConflictReason, conditions.GetReason(host, metal3api.ManageableCondition))
+	assert.Equal(t, expectedMessage, conditions.GetMessage(host, metal3api.ManageableCondition))
+
+	// A different registration error replaces the specific reason.
+	fix.SetRegisterError(nil)
+	fix.SetValidateError("malformed url")
+	tryReconcile(t, r, host,
+		func(host *metal3api.BareMetalHost, result reconcile.Result) bool {
+			return host.Status.ErrorMessage == "malformed url"
+		},
+	)
+	assert.Equal(t, metal3api.StateRegistering, host.Status.Provisioning.State)
+	assert.True(t, conditions.IsFalse(host, metal3api.ManageableCondition))
+	assert.Equal(t, metal3api.RegistrationFailedReason, conditions.GetReason(host, metal3api.ManageableCondition))
+}
+
 func TestCredentialsFromSecret(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -96,6 +96,8 @@ type Fixture struct {
 
 	validateError string
 
+	registerError error
+
 	customDeploy *metal3api.CustomDeploy
 
 	HostFirmwareSettings HostFirmwareSettingsMock
@@ -121,6 +123,11 @@ func (f *Fixture) SetValidateError(message string) {
 	f.validateError = message
 }
 
+// SetRegisterError makes Register return the given error.
+func (f *Fixture) SetRegisterError(err error) {
+	f.registerError = err
+}
+
 func (p *fixtureProvisioner) HasCapacity(_ context.Context) (result bool, err error) {
 	return true, nil
 }
@@ -135,6 +142,11 @@ func (p *fixtureProvisioner) Register(_ context.Context, _ provisioner.Managemen
 		return
 	}
 
+	if p.state.registerError != nil {
+		err = p.state.registerError
+		return
+	}
+
 	// Fill in the ID of the host in the provisioning system
 	if p.provID == "" {
 		provID = "temporary-fake-id"
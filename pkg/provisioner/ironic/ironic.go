@@ -47,20 +47,6 @@ const (
 	kernelParamsKey  = "kernel_append_params"
 )
 
-type macAddressConflictError struct {
-	Address      string
-	ExistingNode string
-}
-
-func (e macAddressConflictError) Error() string {
-	return fmt.Sprintf("MAC address %s conflicts with existing node %s", e.Address, e.ExistingNode)
-}
-
-// NewMacAddressConflictError is a wrap for macAddressConflictError error.
-func NewMacAddressConflictError(address, node string) error {
-	return macAddressConflictError{Address: address, ExistingNode: node}
-}
-
 type ironicConfig struct {
 	havePreprovImgBuilder                 bool
 	deployKernelURL                       string
@@ -278,7 +264,7 @@ func (p *ironicProvisioner) findExistingHost(ctx context.Context, bootMACAddress
 
 				// If the node has a name, this means we didn't find it above.
 				if ironicNode.Name != "" {
-					return nil, NewMacAddressConflictError(bootMACAddress, ironicNode.Name)
+					return nil, provisioner.MACAddressConflictError{Address: bootMACAddress, ExistingNode: ironicNode.Name}
 				}
 
 				return ironicNode, nil
@@ -310,12 +296,65 @@ func (p *ironicProvisioner) createPXEEnabledNodePort(ctx context.Context, uuid,
 			PXEEnabled: &enable,
 		}).Extract()
 	if err != nil {
+		// The port may have been created concurrently by another actor
+		// (e.g. inspection). That is fine as long as it belongs to this node.
+		if gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+			adopted, adoptErr := p.adoptExistingNodePort(ctx, uuid, macAddress)
+			if adoptErr != nil {
+				p.log.Info("failed to check existing ironic port after conflict",
+					"NodeUUID", uuid, "MAC", macAddress, "error", adoptErr.Error())
+			} else if adopted {
+				return nil
+			}
+		}
 		return fmt.Errorf("failed to create ironic port for node %s, MAC: %s: %w", uuid, macAddress, err)
 	}
 
 	return nil
 }
 
+// adoptExistingNodePort looks up the port with the given MAC and, if it is
+// attached to the node, makes sure it is PXE enabled. It returns false if no
+// such port is attached to the node.
+func (p *ironicProvisioner) adoptExistingNodePort(ctx context.Context, uuid, macAddress string) (bool, error) {
+	allPages, err := ports.List(p.client, ports.ListOpts{
+		Address: macAddress,
+		Fields:  []string{"uuid", "node_uuid", "pxe_enabled"},
+	}).AllPages(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to list ports for %s: %w", macAddress, err)
+	}
+
+	existingPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return false, fmt.Errorf("failed to extract ports for %s: %w", macAddress, err)
+	}
+
+	if len(existingPorts) == 0 || existingPorts[0].NodeUUID != uuid {
+		return false, nil
+	}
+
+	port := existingPorts[0]
+	if port.PXEEnabled {
+		p.log.Info("PXE enabled ironic port for node already exists", "NodeUUID", uuid, "MAC", macAddress)
+		return true, nil
+	}
+
+	p.log.Info("enabling PXE on existing ironic port for node", "NodeUUID", uuid, "MAC", macAddress, "port", port.UUID)
+	_, err = ports.Update(ctx, p.client, port.UUID, ports.UpdateOpts{
+		ports.UpdateOperation{
+			Op:    ports.ReplaceOp,
+			Path:  "/pxe_enabled",
+			Value: true,
+		},
+	}).Extract()
+	if err != nil {
+		return false, fmt.Errorf("failed to enable PXE on ironic port %s: %w", port.UUID, err)
+	}
+
+	return true, nil
+}
+
 // configureNode configures Node properties that are not related to any specific provisioning phase.
 // It populates the AutomatedClean field, as well as capabilities and architecture in Properties.
 // It also calls setDeployImage to populate IPA parameters in DriverInfo and
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"reflect"
 	"regexp"
@@ -77,6 +78,17 @@ func (p *ironicProvisioner) Register(ctx context.Context, data provisioner.Manag
 		return result, "", err
 	}
 
+	// Reject a malformed MAC before it is used to look up or create
+	// ports, otherwise the failure surfaces as an opaque Ironic error.
+	if p.bootMACAddress != "" {
+		if _, macErr := net.ParseMAC(p.bootMACAddress); macErr != nil {
+			msg := fmt.Sprintf("BootMACAddress %q is not a valid MAC address: %s", p.bootMACAddress, macErr)
+			p.log.Info(msg)
+			result, err = operationFailed(msg)
+			return result, "", err
+		}
+	}
+
 	var ironicNode *nodes.Node
 	updater := clients.UpdateOptsBuilder(p.log)
 
@@ -84,12 +96,13 @@ func (p *ironicProvisioner) Register(ctx context.Context, data provisioner.Manag
 
 	ironicNode, err = p.findExistingHost(ctx, p.bootMACAddress)
 	if err != nil {
-		var target macAddressConflictError
-		if errors.As(err, &target) {
-			result, err = operationFailed(target.Error())
-		} else {
-			result, err = transientError(fmt.Errorf("failed to find existing host: %w", err))
+		// A MAC address conflict is permanent. It is returned as is so
+		// that the controller can report it with a dedicated condition
+		// reason.
+		if errors.As(err, &provisioner.MACAddressConflictError{}) {
+			return provisioner.Result{}, "", err
 		}
+		result, err = transientError(fmt.Errorf("failed to find existing host: %w", err))
 		return result, "", err
 	}
 
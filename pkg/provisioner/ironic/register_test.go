@@ -638,7 +638,7 @@ func TestRegisterExistingPortButHasName(t *testing.T) {
 	// The port is linked to the node.
 	// The port address matches the BMH BootMACAddress.
 	// The node has a name, and the name doesn't match the BMH.
-	// Register should return an error.
+	// Register should return a MAC address conflict error.
 
 	existingNode := nodes.Node{
 		UUID: "33ce8659-7400-4c68-9535-d10766f07a58",
@@ -670,9 +670,12 @@ func TestRegisterExistingPortButHasName(t *testing.T) {
 		t.Fatalf("could not create provisioner: %s", err)
 	}
 
-	res, _, err := prov.Register(t.Context(), provisioner.ManagementAccessData{}, false, false)
-	require.NoError(t, err)
-	assert.Equal(t, "MAC address 11:11:11:11:11:11 conflicts with existing node wrong-name", res.ErrorMessage)
+	_, _, err = prov.Register(t.Context(), provisioner.ManagementAccessData{}, false, false)
+	var conflict provisioner.MACAddressConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, "11:11:11:11:11:11", conflict.Address)
+	assert.Equal(t, "wrong-name", conflict.ExistingNode)
+	assert.Equal(t, "MAC address 11:11:11:11:11:11 conflicts with existing node wrong-name", err.Error())
 }
 
 func TestRegisterAddTwoHostsWithSameMAC(t *testing.T) {
@@ -716,6 +719,77 @@ func TestRegisterAddTwoHostsWithSameMAC(t *testing.T) {
 	assert.NotEmpty(t, provID)
 }
 
+func TestCreatePXEEnabledNodePortConflict(t *testing.T) {
+	cases := []struct {
+		name          string
+		existingPort  ports.Port
+		expectPatch   bool
+		expectedError string
+	}{
+		{
+			name: "conflict-same-node",
+			existingPort: ports.Port{
+				UUID:       "port-uuid",
+				NodeUUID:   "uuid",
+				Address:    "11:11:11:11:11:11",
+				PXEEnabled: true,
+			},
+		},
+		{
+			name: "conflict-same-node-pxe-disabled",
+			existingPort: ports.Port{
+				UUID:       "port-uuid",
+				NodeUUID:   "uuid",
+				Address:    "11:11:11:11:11:11",
+				PXEEnabled: false,
+			},
+			expectPatch: true,
+		},
+		{
+			name: "conflict-other-node",
+			existingPort: ports.Port{
+				UUID:       "port-uuid",
+				NodeUUID:   "other-uuid",
+				Address:    "11:11:11:11:11:11",
+				PXEEnabled: true,
+			},
+			expectedError: "failed to create ironic port for node uuid, MAC: 11:11:11:11:11:11",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ironic := testserver.NewIronic(t).Port(tc.existingPort)
+			ironic.ResponseWithCode("/v1/ports:"+http.MethodPost, "{}", http.StatusConflict)
+			ironic.ResponseWithCode("/v1/ports/port-uuid:"+http.MethodPatch, "{}", http.StatusOK)
+			ironic.Start()
+			defer ironic.Stop()
+
+			host := makeHost()
+			host.Spec.BootMACAddress = "11:11:11:11:11:11"
+
+			auth := clients.AuthConfig{Type: clients.NoAuth}
+			prov, err := newProvisionerWithSettings(host, bmc.Credentials{}, nullEventPublisher, ironic.Endpoint(), auth)
+			if err != nil {
+				t.Fatalf("could not create provisioner: %s", err)
+			}
+
+			err = prov.createPXEEnabledNodePort(t.Context(), "uuid", host.Spec.BootMACAddress)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedError)
+			}
+
+			patch, patched := ironic.GetLastRequestFor("/v1/ports/port-uuid", http.MethodPatch)
+			assert.Equal(t, tc.expectPatch, patched)
+			if tc.expectPatch {
+				assert.JSONEq(t, `[{"op":"replace","path":"/pxe_enabled","value":true}]`, patch)
+			}
+		})
+	}
+}
+
 func TestRegisterUnsupportedSecureBoot(t *testing.T) {
 	// Create a host without a bootMACAddress and with a BMC that
 	// requires one.
@@ -805,6 +879,31 @@ func TestRegisterMalformedBMCAddress(t *testing.T) {
 	assert.Equal(t, "failed to parse BMC address information: failed to parse BMC address information: parse \"<ipmi://192.168.122.1:6233>\": first path segment in URL cannot contain colon", result.ErrorMessage)
 }
 
+func TestRegisterMalformedBootMAC(t *testing.T) {
+	// No Ironic responses are configured: the malformed MAC must be
+	// rejected before any port or node lookup is attempted.
+	ironic := testserver.NewIronic(t)
+	ironic.Start()
+	defer ironic.Stop()
+
+	host := makeHost()
+	host.Spec.BootMACAddress = "11:11:11:11:11"
+	host.Status.Provisioning.ID = "" // so we don't lookup by uuid
+
+	auth := clients.AuthConfig{Type: clients.NoAuth}
+	prov, err := newProvisionerWithSettings(host, bmc.Credentials{}, nullEventPublisher, ironic.Endpoint(), auth)
+	if err != nil {
+		t.Fatalf("could not create provisioner: %s", err)
+	}
+
+	result, _, err := prov.Register(t.Context(), provisioner.ManagementAccessData{}, false, false)
+	if err != nil {
+		t.Fatalf("error from Register: %s", err)
+	}
+	assert.Equal(t, "BootMACAddress \"11:11:11:11:11\" is not a valid MAC address: address 11:11:11:11:11: invalid MAC address", result.ErrorMessage)
+	assert.Empty(t, ironic.Requests)
+}
+
 func TestRegisterUpdateBMCAddressIP(t *testing.T) {
 	host := makeHost()
 	host.Spec.BMC.Address = "ipmi://192.168.122.10:623"
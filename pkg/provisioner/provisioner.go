@@ -3,6 +3,7 @@ package provisioner
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	metal3api "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
@@ -265,3 +266,14 @@ var ErrFirmwareUpdateUnsupported = errors.New("host does not support Firmware Up
 // ErrNodeIsBusy is returned when the node is busy due to being reserved for another
 // task.
 var ErrNodeIsBusy = errors.New("node is busy")
+
+// MACAddressConflictError is returned when the boot MAC address of the
+// host is already associated with a different node in the provisioner.
+type MACAddressConflictError struct {
+	Address      string
+	ExistingNode string
+}
+
+func (e MACAddressConflictError) Error() string {
+	return fmt.Sprintf("MAC address %s conflicts with existing node %s", e.Address, e.ExistingNode)
+}